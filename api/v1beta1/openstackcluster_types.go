@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenStackIdentityReference points to the Secret or clouds.yaml entry containing the OpenStack
+// credentials used to reconcile a cluster or machine.
+type OpenStackIdentityReference struct {
+	// CloudName is the name of the entry in the clouds.yaml file to use.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="identityRef.cloudName is immutable"
+	CloudName string `json:"cloudName"`
+
+	// Name is the name of the secret containing the credentials.
+	Name string `json:"name"`
+}
+
+// OpenStackClusterSpec defines the desired state of an OpenStackCluster.
+// +kubebuilder:validation:XValidation:rule="!(has(self.apiServerFloatingIP) && has(self.disableExternalNetwork) && self.disableExternalNetwork)",message="floatingIP and disableExternalNetwork are mutually exclusive"
+type OpenStackClusterSpec struct {
+	// IdentityRef is a reference to a secret holding OpenStack credentials.
+	IdentityRef OpenStackIdentityReference `json:"identityRef"`
+
+	// APIServerFloatingIP is the floating IP address to attach to the API server, bypassing the
+	// automatic floating IP allocation done when APIServerFloatingIP is unset.
+	// +optional
+	APIServerFloatingIP *string `json:"apiServerFloatingIP,omitempty"`
+
+	// DisableExternalNetwork disables the creation of an external network on the cluster, so the
+	// control plane is only reachable through an existing network. It cannot be set to true together
+	// with APIServerFloatingIP.
+	// +optional
+	DisableExternalNetwork *bool `json:"disableExternalNetwork,omitempty"`
+}
+
+// OpenStackClusterStatus defines the observed state of an OpenStackCluster.
+type OpenStackClusterStatus struct {
+	// Ready denotes that the OpenStack cluster infrastructure is fully provisioned.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+}
+
+// OpenStackCluster is the Schema for the openstackclusters API.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type OpenStackCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackClusterSpec   `json:"spec,omitempty"`
+	Status OpenStackClusterStatus `json:"status,omitempty"`
+}
+
+// OpenStackClusterList contains a list of OpenStackCluster.
+// +kubebuilder:object:root=true
+type OpenStackClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackCluster `json:"items"`
+}
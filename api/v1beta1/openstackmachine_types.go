@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlockDeviceType is the type of a root or additional block device.
+type BlockDeviceType string
+
+const (
+	// LocalBlockDevice stores the volume on the hypervisor hosting the instance. It cannot move with
+	// the instance between availability zones.
+	LocalBlockDevice BlockDeviceType = "Local"
+
+	// VolumeBlockDevice stores the volume in Cinder. It can only be attached to an instance in the
+	// same availability zone as the volume, unless the Cinder backend supports cross-AZ attachment.
+	VolumeBlockDevice BlockDeviceType = "Volume"
+)
+
+// BlockDeviceStorage defines how a root or additional block device is backed.
+type BlockDeviceStorage struct {
+	// Type is the type of block device to create.
+	// +kubebuilder:validation:Enum=Local;Volume
+	Type BlockDeviceType `json:"type"`
+}
+
+// RootVolume defines the characteristics of a volume-backed root block device.
+type RootVolume struct {
+	// SizeGiB is the size of the block device in gibibytes.
+	SizeGiB int `json:"sizeGiB"`
+
+	// Storage defines how the root volume is backed.
+	Storage BlockDeviceStorage `json:"storage"`
+}
+
+// AdditionalBlockDevice is a block device to attach to the instance in addition to its root volume.
+type AdditionalBlockDevice struct {
+	// Name is used to identify the block device within the machine's list of additional block devices.
+	Name string `json:"name"`
+
+	// SizeGiB is the size of the block device in gibibytes.
+	SizeGiB int `json:"sizeGiB"`
+
+	// Storage defines how the additional block device is backed.
+	Storage BlockDeviceStorage `json:"storage"`
+}
+
+// ImageFilter describes criteria used to look up an image when ID isn't known.
+type ImageFilter struct {
+	// Name is the name of the image to look up.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// ImageParam specifies an OpenStack image, either directly by ID or by filter.
+type ImageParam struct {
+	// ID is the ID of the image to use, takes precedence over Filter if both are set.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Filter describes criteria used to look up an image when ID isn't known.
+	// +optional
+	Filter *ImageFilter `json:"filter,omitempty"`
+}
+
+// NetworkParam specifies an OpenStack network, either directly by ID or by filter.
+type NetworkParam struct {
+	// ID is the ID of the network to use.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// SubnetParam specifies an OpenStack subnet, either directly by ID or by filter.
+type SubnetParam struct {
+	// ID is the ID of the subnet to use.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// FixedIP specifies a fixed IP to allocate for a port from a given subnet.
+type FixedIP struct {
+	// Subnet is the subnet the fixed IP is allocated from. It must belong to the port's Network.
+	// +optional
+	Subnet *SubnetParam `json:"subnet,omitempty"`
+}
+
+// PortOpts specifies the configuration of a port to create on the instance.
+// +kubebuilder:validation:XValidation:rule="!has(self.network) || !has(self.network.id) || !has(self.fixedIPs) || self.fixedIPs.all(f, !has(f.subnet) || !has(f.subnet.id) || f.subnet.id == self.network.id)",message="fixedIPs[].subnet must belong to the port's network"
+type PortOpts struct {
+	// Network is the network the port will be created on. Required if FixedIPs is set.
+	// +optional
+	Network *NetworkParam `json:"network,omitempty"`
+
+	// FixedIPs is a list of fixed IPs to allocate for the port.
+	// +optional
+	FixedIPs []FixedIP `json:"fixedIPs,omitempty"`
+}
+
+// OpenStackMachineSpec defines the desired state of an OpenStackMachine.
+// +kubebuilder:validation:XValidation:rule="!(has(self.rootVolume) && self.rootVolume.storage.type == 'Volume') || has(self.availabilityZone)",message="availabilityZone is required when rootVolume.storage.type is Volume"
+// +kubebuilder:validation:XValidation:rule="!has(self.additionalBlockDevices) || !self.additionalBlockDevices.exists(d, d.storage.type == 'Volume') || has(self.availabilityZone)",message="availabilityZone is required when additionalBlockDevices[].storage.type is Volume"
+type OpenStackMachineSpec struct {
+	// Flavor is the OpenStack flavor to use for the instance.
+	// +kubebuilder:validation:Required
+	Flavor *string `json:"flavor,omitempty"`
+
+	// Image specifies the OpenStack image the instance boots from.
+	// +kubebuilder:validation:Required
+	Image ImageParam `json:"image,omitempty"`
+
+	// AvailabilityZone is the availability zone in which to create the instance. Required when
+	// RootVolume or any AdditionalBlockDevices use Volume storage, since Cinder can only guarantee
+	// the volume and the instance land in the same zone when both are pinned explicitly.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// Ports is the list of ports to create on the instance.
+	// +optional
+	Ports []PortOpts `json:"ports,omitempty"`
+
+	// RootVolume, if set, makes the instance's root block device a Cinder volume instead of
+	// ephemeral storage on the hypervisor.
+	// +optional
+	RootVolume *RootVolume `json:"rootVolume,omitempty"`
+
+	// AdditionalBlockDevices is a list of block devices to attach to the instance in addition to
+	// its root volume.
+	// +optional
+	AdditionalBlockDevices []AdditionalBlockDevice `json:"additionalBlockDevices,omitempty"`
+}
+
+// OpenStackMachineStatus defines the observed state of an OpenStackMachine.
+type OpenStackMachineStatus struct {
+	// Ready denotes that the OpenStack instance is fully provisioned.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+}
+
+// OpenStackMachine is the Schema for the openstackmachines API.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type OpenStackMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackMachineSpec   `json:"spec,omitempty"`
+	Status OpenStackMachineStatus `json:"status,omitempty"`
+}
+
+// OpenStackMachineList contains a list of OpenStackMachine.
+// +kubebuilder:object:root=true
+type OpenStackMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackMachine `json:"items"`
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Most of the cross-field invariants these webhooks used to enforce (see config/crd/bases) now live as
+// x-kubernetes-validations CEL rules on the CRDs themselves, so they're rejected by the apiserver before
+// a webhook call would even happen. What's left here is the immutability check on the two template types,
+// plus the webhook registration every type needs for the apiserver to route admission requests to it.
+
+// SetupWebhookWithManager registers the OpenStackCluster validating webhook with mgr.
+func (r *OpenStackCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&openStackClusterWebhook{}).
+		Complete()
+}
+
+type openStackClusterWebhook struct{}
+
+func (*openStackClusterWebhook) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (*openStackClusterWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWebhookWithManager registers the OpenStackClusterList webhook with mgr. The list type carries no
+// validation of its own; it exists so a caller can wait for the list's informer to be ready the same way
+// it waits on every other registered webhook type.
+func (r *OpenStackClusterList) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+// SetupWebhookWithManager registers the OpenStackClusterTemplate validating webhook with mgr.
+func (r *OpenStackClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&openStackClusterTemplateWebhook{}).
+		Complete()
+}
+
+type openStackClusterTemplateWebhook struct{}
+
+func (*openStackClusterTemplateWebhook) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate rejects changes to an OpenStackClusterTemplate's template spec: like every other
+// ClusterClass-referenced template in Cluster API, it's immutable once created, and clusterctl move/rotate
+// flows create a new template object instead of editing one in place.
+func (*openStackClusterTemplateWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldTemplate, ok := oldObj.(*OpenStackClusterTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackClusterTemplate but got %T", oldObj)
+	}
+	newTemplate, ok := newObj.(*OpenStackClusterTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackClusterTemplate but got %T", newObj)
+	}
+	if !reflect.DeepEqual(oldTemplate.Spec.Template.Spec, newTemplate.Spec.Template.Spec) {
+		return nil, fmt.Errorf("OpenStackClusterTemplate.spec.template.spec is immutable")
+	}
+	return nil, nil
+}
+
+func (*openStackClusterTemplateWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWebhookWithManager registers the OpenStackMachine validating webhook with mgr.
+func (r *OpenStackMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&openStackMachineWebhook{}).
+		Complete()
+}
+
+type openStackMachineWebhook struct{}
+
+func (*openStackMachineWebhook) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (*openStackMachineWebhook) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (*openStackMachineWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWebhookWithManager registers the OpenStackMachineList webhook with mgr.
+func (r *OpenStackMachineList) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+// OpenStackMachineTemplateWebhook validates OpenStackMachineTemplate, kept as a standalone validator
+// (rather than methods on OpenStackMachineTemplate itself) so it can be unit tested without pulling in
+// the rest of the CRD type's generated deepcopy/object-root machinery.
+type OpenStackMachineTemplateWebhook struct{}
+
+// SetupWebhookWithManager registers OpenStackMachineTemplateWebhook as the validator for
+// OpenStackMachineTemplate with mgr.
+func (w *OpenStackMachineTemplateWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&OpenStackMachineTemplate{}).
+		WithValidator(w).
+		Complete()
+}
+
+func (*OpenStackMachineTemplateWebhook) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate rejects changes to an OpenStackMachineTemplate's template spec, for the same reason
+// OpenStackClusterTemplate does: MachineDeployments/MachineSets roll out a new template generation rather
+// than mutating an existing one in place.
+func (*OpenStackMachineTemplateWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldTemplate, ok := oldObj.(*OpenStackMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackMachineTemplate but got %T", oldObj)
+	}
+	newTemplate, ok := newObj.(*OpenStackMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackMachineTemplate but got %T", newObj)
+	}
+	if !reflect.DeepEqual(oldTemplate.Spec.Template.Spec, newTemplate.Spec.Template.Spec) {
+		return nil, fmt.Errorf("OpenStackMachineTemplate.spec.template.spec is immutable")
+	}
+	return nil, nil
+}
+
+func (*OpenStackMachineTemplateWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWebhookWithManager registers the OpenStackMachineTemplateList webhook with mgr.
+func (r *OpenStackMachineTemplateList) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+var _ webhook.CustomValidator = &openStackClusterWebhook{}
+var _ webhook.CustomValidator = &openStackClusterTemplateWebhook{}
+var _ webhook.CustomValidator = &openStackMachineWebhook{}
+var _ webhook.CustomValidator = &OpenStackMachineTemplateWebhook{}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type deleteOptions struct {
+	includeCRDs      bool
+	includeNamespace bool
+	infrastructure   string
+}
+
+func newDeleteCmd() *cobra.Command {
+	o := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Remove the OpenStack infrastructure provider from the target cluster",
+		Long:  "delete removes the OpenStack infrastructure provider, and optionally its CRDs and namespace, from the target cluster. Cluster API core and other providers are left untouched.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(cmd.Context(), o)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.infrastructure, "infrastructure", "openstack", "infrastructure provider to delete")
+	cmd.Flags().BoolVar(&o.includeCRDs, "include-crds", false, "also delete the provider's CRDs")
+	cmd.Flags().BoolVar(&o.includeNamespace, "include-namespace", false, "also delete the provider's namespace")
+
+	return cmd
+}
+
+func runDelete(ctx context.Context, o *deleteOptions) error {
+	c, err := newClusterctlClient()
+	if err != nil {
+		return fmt.Errorf("failed to create clusterctl client: %w", err)
+	}
+
+	return c.Delete(ctx, client.DeleteOptions{
+		Kubeconfig:              client.Kubeconfig{Path: rootOptions.kubeconfig, Context: rootOptions.kubeconfigContext},
+		InfrastructureProviders: []string{o.infrastructure},
+		IncludeCRDs:             o.includeCRDs,
+		IncludeNamespace:        o.includeNamespace,
+	})
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type describeOptions struct {
+	namespace string
+}
+
+func newDescribeCmd() *cobra.Command {
+	o := &describeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "List the providers installed in the target cluster",
+		Long:  "describe lists the Cluster API core, bootstrap, control-plane and infrastructure providers currently installed in the target cluster, along with their installed version and watch namespace.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDescribe(cmd.Context(), o)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.namespace, "namespace", "", "only list providers installed in this namespace")
+
+	return cmd
+}
+
+func runDescribe(ctx context.Context, o *describeOptions) error {
+	c, err := newClusterctlClient()
+	if err != nil {
+		return fmt.Errorf("failed to create clusterctl client: %w", err)
+	}
+
+	installed, err := c.GetProviderComponents(ctx, "", client.ListOptions{
+		Kubeconfig: client.Kubeconfig{Path: rootOptions.kubeconfig, Context: rootOptions.kubeconfigContext},
+		Namespace:  o.namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list installed providers: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tNAMESPACE\tTYPE\tVERSION")
+	for _, p := range installed {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, p.Namespace, p.Type, p.Version)
+	}
+	return w.Flush()
+}
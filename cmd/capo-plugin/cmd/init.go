@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type initOptions struct {
+	targetNamespace    string
+	infrastructure     string
+	bootstrapProviders []string
+	controlPlane       []string
+	waitProviders      bool
+}
+
+func newInitCmd() *cobra.Command {
+	o := &initOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Install Cluster API, cert-manager and the OpenStack infrastructure provider",
+		Long:  "init installs cert-manager (if not already present), the Cluster API core, bootstrap and control-plane providers, and the OpenStack infrastructure provider into the target cluster.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInit(cmd.Context(), o)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.targetNamespace, "target-namespace", "", "namespace where the providers should be installed (defaults to each provider's default namespace)")
+	cmd.Flags().StringVar(&o.infrastructure, "infrastructure", "openstack", "infrastructure provider and version to install, e.g. openstack:v0.11.0")
+	cmd.Flags().StringSliceVar(&o.bootstrapProviders, "bootstrap", []string{"kubeadm"}, "bootstrap providers and versions to install")
+	cmd.Flags().StringSliceVar(&o.controlPlane, "control-plane", []string{"kubeadm"}, "control plane providers and versions to install")
+	cmd.Flags().BoolVar(&o.waitProviders, "wait-providers", true, "wait for providers to be running before returning")
+
+	return cmd
+}
+
+func runInit(ctx context.Context, o *initOptions) error {
+	c, err := newClusterctlClient()
+	if err != nil {
+		return fmt.Errorf("failed to create clusterctl client: %w", err)
+	}
+
+	_, err = c.Init(ctx, client.InitOptions{
+		Kubeconfig:              client.Kubeconfig{Path: rootOptions.kubeconfig, Context: rootOptions.kubeconfigContext},
+		TargetNamespace:         o.targetNamespace,
+		CoreProvider:            "cluster-api",
+		BootstrapProviders:      o.bootstrapProviders,
+		ControlPlaneProviders:   o.controlPlane,
+		InfrastructureProviders: []string{o.infrastructure},
+		WaitProviders:           o.waitProviders,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize providers: %w", err)
+	}
+
+	return nil
+}
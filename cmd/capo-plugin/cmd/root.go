@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmd implements the capo-plugin CLI commands.
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+// cliOptions are the flags shared by every subcommand.
+type cliOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	configFile        string
+}
+
+var rootOptions = &cliOptions{}
+
+// RootCmd returns the root `capo-plugin` cobra command with every subcommand attached.
+func RootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:          "capo-plugin",
+		Short:        "Install and manage the Cluster API OpenStack provider",
+		Long:         "capo-plugin installs Cluster API, its bootstrap/control-plane providers, cert-manager and the OpenStack infrastructure provider into a target cluster, reusing clusterctl as a library.",
+		SilenceUsage: true,
+	}
+
+	rootCmd.PersistentFlags().StringVar(&rootOptions.kubeconfig, "kubeconfig", "", "path to the kubeconfig for the target cluster (defaults to the current kubectl context)")
+	rootCmd.PersistentFlags().StringVar(&rootOptions.kubeconfigContext, "kubeconfig-context", "", "context within the kubeconfig to use")
+	rootCmd.PersistentFlags().StringVar(&rootOptions.configFile, "config", "", "path to a clusterctl-style configuration file")
+
+	rootCmd.AddCommand(newInitCmd())
+	rootCmd.AddCommand(newUpgradeCmd())
+	rootCmd.AddCommand(newDeleteCmd())
+	rootCmd.AddCommand(newDescribeCmd())
+
+	return rootCmd
+}
+
+// newClusterctlClient builds the clusterctl library client shared by every subcommand, honouring
+// --config when the user pointed at a non-default clusterctl configuration file.
+func newClusterctlClient() (client.Client, error) {
+	if rootOptions.configFile != "" {
+		return client.New(context.TODO(), rootOptions.configFile)
+	}
+	return client.New(context.TODO(), "")
+}
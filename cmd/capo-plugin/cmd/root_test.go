@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import "testing"
+
+func TestRootCmdRegistersSubcommands(t *testing.T) {
+	root := RootCmd()
+
+	for _, name := range []string{"init", "upgrade", "delete", "describe"} {
+		if root.Commands() == nil {
+			t.Fatalf("RootCmd() registered no subcommands, want %q", name)
+		}
+		if cmd, _, err := root.Find([]string{name}); err != nil || cmd.Name() != name {
+			t.Errorf("RootCmd() did not register a %q subcommand", name)
+		}
+	}
+}
+
+func TestUpgradeCmdRequiresInfrastructureFlag(t *testing.T) {
+	cmd := newUpgradeCmd()
+
+	flag := cmd.Flags().Lookup("infrastructure")
+	if flag == nil {
+		t.Fatal("upgrade command has no --infrastructure flag")
+	}
+	if required := flag.Annotations["cobra_annotation_bash_completion_one_required_flag"]; len(required) == 0 {
+		t.Error("upgrade --infrastructure flag should be marked required")
+	}
+}
+
+func TestDeleteCmdFlags(t *testing.T) {
+	cmd := newDeleteCmd()
+
+	for _, name := range []string{"infrastructure", "include-crds", "include-namespace"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("delete command has no --%s flag", name)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type upgradeOptions struct {
+	infrastructure string
+	contract       string
+}
+
+func newUpgradeCmd() *cobra.Command {
+	o := &upgradeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the OpenStack infrastructure provider to a newer version",
+		Long:  "upgrade applies the plan required to move the installed OpenStack infrastructure provider (and, where needed, Cluster API core) to a newer version.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUpgrade(cmd.Context(), o)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.infrastructure, "infrastructure", "", "infrastructure provider and target version, e.g. openstack:v0.12.0 (required)")
+	cmd.Flags().StringVar(&o.contract, "contract", "", "upgrade every provider to the latest version supporting this Cluster API contract, e.g. v1beta1")
+	_ = cmd.MarkFlagRequired("infrastructure")
+
+	return cmd
+}
+
+func runUpgrade(ctx context.Context, o *upgradeOptions) error {
+	c, err := newClusterctlClient()
+	if err != nil {
+		return fmt.Errorf("failed to create clusterctl client: %w", err)
+	}
+
+	kubeconfig := client.Kubeconfig{Path: rootOptions.kubeconfig, Context: rootOptions.kubeconfigContext}
+
+	if o.contract != "" {
+		return c.ApplyUpgrade(ctx, client.ApplyUpgradeOptions{
+			Kubeconfig: kubeconfig,
+			Contract:   o.contract,
+		})
+	}
+
+	return c.ApplyUpgrade(ctx, client.ApplyUpgradeOptions{
+		Kubeconfig:              kubeconfig,
+		InfrastructureProviders: []string{o.infrastructure},
+	})
+}
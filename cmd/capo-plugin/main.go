@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// capo-plugin is a kubectl plugin (invoked as `kubectl capo`) that installs, upgrades and removes
+// Cluster API and the OpenStack infrastructure provider from a target cluster without requiring a
+// separate clusterctl binary.
+package main
+
+import (
+	"os"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/cmd/capo-plugin/cmd"
+)
+
+func main() {
+	if err := cmd.RootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,166 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+)
+
+// AddonKind distinguishes how an addon manifest should be wrapped so the cluster template can select it
+// with a ClusterResourceSet label selector.
+type AddonKind string
+
+const (
+	// ConfigMapAddon materializes the manifest as a ClusterResourceSet ConfigMap resource, applied as-is.
+	ConfigMapAddon AddonKind = "ConfigMap"
+	// SecretAddon materializes the manifest as a ClusterResourceSet Secret resource, for addons that ship
+	// credentials (e.g. the OpenStack cloud-config consumed by the cloud-controller-manager).
+	SecretAddon AddonKind = "Secret"
+)
+
+// addonTransformation describes one FileTransformation registered against the clusterctl local repository.
+type addonTransformation struct {
+	varName  string
+	filePath string
+	kind     AddonKind
+}
+
+// registeredAddons accumulates every addon transformation registered via RegisterAddonTransformation, in
+// registration order, so createClusterctlLocalRepository can apply them deterministically.
+var registeredAddons []addonTransformation
+
+// RegisterAddonTransformation registers a clusterctl FileTransformation that injects the manifest named
+// by the e2e config variable varName in place of the envsubst variable varName, materialized as the given
+// ClusterResourceSet resource kind. Cluster templates reference varName (e.g. ${OCCM_PATH}) and select the
+// resulting ClusterResourceSet via a matching label. filePath is recorded with the addon but
+// applyAddonTransformations does not read it: the injected file is always the one the config variable
+// points at.
+//
+// This generalizes the single hard-coded CNI_RESOURCES transformation into a pluggable API so addons
+// like the OpenStack cloud-controller-manager, cinder-csi and MetalLB can be composed the same way CNI
+// already is.
+func RegisterAddonTransformation(varName, filePath string, kind AddonKind) {
+	registeredAddons = append(registeredAddons, addonTransformation{
+		varName:  varName,
+		filePath: filePath,
+		kind:     kind,
+	})
+}
+
+// applyAddonTransformations wires every addon registered via RegisterAddonTransformation into the
+// clusterctl repository creation input. Unlike the CNI transformation in createClusterctlLocalRepository,
+// these addons are opt-in: a suite that hasn't registered a given addon, or whose config doesn't define
+// the addon's variable, simply doesn't get that ClusterResourceSet rather than failing the whole run.
+func applyAddonTransformations(config *clusterctl.E2EConfig, input *clusterctl.CreateRepositoryInput) {
+	for _, addon := range registeredAddons {
+		if _, ok := config.Variables[addon.varName]; !ok {
+			continue
+		}
+		path := config.GetVariable(addon.varName)
+		Expect(path).To(BeAnExistingFile(), "The %s variable should resolve to an existing file", addon.varName)
+
+		switch addon.kind {
+		case SecretAddon:
+			input.RegisterClusterResourceSetSecretTransformation(path, addon.varName)
+		case ConfigMapAddon:
+			fallthrough
+		default:
+			input.RegisterClusterResourceSetConfigMapTransformation(path, addon.varName)
+		}
+	}
+}
+
+const (
+	// occmNamespace/occmDeploymentName and cinderCSINamespace/cinderCSIDeploymentName are the well-known
+	// locations the addon manifests referenced by RegisterDefaultAddons install their Deployment to.
+	occmNamespace           = "kube-system"
+	occmDeploymentName      = "openstack-cloud-controller-manager"
+	cinderCSINamespace      = "kube-system"
+	cinderCSIDeploymentName = "openstack-cinder-csi-controllerplugin"
+)
+
+// RegisterDefaultAddons registers the OpenStack addon transformations (cloud-controller-manager,
+// cinder-csi, MetalLB) shared by the quick-start-derived suites. It is opt-in rather than an init(), so a
+// suite that doesn't provision a workload cluster (e.g. apivalidations) never pulls in these variables.
+func RegisterDefaultAddons() {
+	RegisterAddonTransformation("OCCM_PATH", "data/addons/openstack-cloud-controller-manager.yaml", ConfigMapAddon)
+	RegisterAddonTransformation("CINDER_CSI_PATH", "data/addons/cinder-csi.yaml", ConfigMapAddon)
+	RegisterAddonTransformation("METALLB_PATH", "data/addons/metallb.yaml", ConfigMapAddon)
+}
+
+// WaitForDefaultAddonsReady waits for the OCCM and cinder-csi Deployments registered by
+// RegisterDefaultAddons to become Ready on workloadProxy. MetalLB is intentionally left out: unlike OCCM
+// and cinder-csi it isn't needed for the cluster to be otherwise functional, so callers that configure it
+// should wait for it explicitly instead of every suite paying for it unconditionally. Each addon is only
+// waited for if its corresponding variable was actually set in config, consistent with
+// applyAddonTransformations treating these addons as opt-in.
+func WaitForDefaultAddonsReady(ctx context.Context, config *clusterctl.E2EConfig, workloadProxy framework.ClusterProxy, intervals ...interface{}) {
+	if _, ok := config.Variables["OCCM_PATH"]; ok {
+		WaitForAddonDeploymentReady(ctx, WaitForAddonDeploymentReadyInput{
+			WorkloadClusterProxy: workloadProxy,
+			Namespace:            occmNamespace,
+			DeploymentName:       occmDeploymentName,
+		}, intervals...)
+	}
+
+	if _, ok := config.Variables["CINDER_CSI_PATH"]; ok {
+		WaitForAddonDeploymentReady(ctx, WaitForAddonDeploymentReadyInput{
+			WorkloadClusterProxy: workloadProxy,
+			Namespace:            cinderCSINamespace,
+			DeploymentName:       cinderCSIDeploymentName,
+		}, intervals...)
+	}
+}
+
+// WaitForAddonDeploymentReadyInput is the input for WaitForAddonDeploymentReady.
+type WaitForAddonDeploymentReadyInput struct {
+	WorkloadClusterProxy framework.ClusterProxy
+	Namespace            string
+	DeploymentName       string
+}
+
+// WaitForAddonDeploymentReady asserts that the Deployment backing an addon installed via a
+// ClusterResourceSet (OCCM, cinder-csi, MetalLB, ...) becomes Ready on the workload cluster.
+// WaitForDefaultAddonsReady calls this once per default addon that is configured for the current test
+// run, instead of hand-rolling an install-and-wait step for each one.
+func WaitForAddonDeploymentReady(ctx context.Context, input WaitForAddonDeploymentReadyInput, intervals ...interface{}) {
+	By("Waiting for addon Deployment " + input.Namespace + "/" + input.DeploymentName + " to be Ready")
+
+	deployment := &appsv1.Deployment{}
+	workloadClient := input.WorkloadClusterProxy.GetClient()
+
+	Eventually(func() (bool, error) {
+		if err := workloadClient.Get(ctx, types.NamespacedName{Namespace: input.Namespace, Name: input.DeploymentName}, deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return deployment.Status.ReadyReplicas > 0 && deployment.Status.ReadyReplicas == *deployment.Spec.Replicas, nil
+	}, intervals...).Should(BeTrue(), "addon Deployment %s/%s never became Ready", input.Namespace, input.DeploymentName)
+}
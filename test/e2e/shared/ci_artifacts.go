@@ -0,0 +1,116 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// ciArtifactsURLEnvVar contains the URL of a bucket of CI artifacts, used by the CI e2e jobs to download
+	// kubeadm/kubelet/kubectl built from a PR or from the tip of a release branch instead of a published release.
+	ciArtifactsURLEnvVar = "CI_VERSION_URL"
+
+	// ciArtifactsKubernetesVersionEnvVar pins the CI build to download, e.g. "v1.30.0-beta.0.10+abcdef0123456".
+	ciArtifactsKubernetesVersionEnvVar = "CI_VERSION"
+
+	defaultCIArtifactsURL = "https://storage.googleapis.com/k8s-release-dev/ci"
+)
+
+// ciArtifact describes a single CI-built binary that needs to end up in the workload image.
+type ciArtifact struct {
+	// sourcePath is the path of the artifact relative to the CI artifacts bucket for a given version.
+	sourcePath string
+	// targetPath is where the artifact should be written to on the workload node.
+	targetPath string
+	// targetMode is the file mode the artifact should be written with.
+	targetMode os.FileMode
+}
+
+var ciArtifacts = []ciArtifact{
+	{sourcePath: "bin/linux/amd64/kubeadm", targetPath: "/usr/bin/kubeadm", targetMode: 0755},
+	{sourcePath: "bin/linux/amd64/kubelet", targetPath: "/usr/bin/kubelet", targetMode: 0755},
+	{sourcePath: "bin/linux/amd64/kubectl", targetPath: "/usr/bin/kubectl", targetMode: 0755},
+}
+
+// resolveCIVersion returns the Kubernetes CI build to use for this test run, honouring ciArtifactsKubernetesVersionEnvVar
+// when set and falling back to the latest green CI build otherwise.
+func resolveCIVersion(ctx context.Context) string {
+	if v := os.Getenv(ciArtifactsKubernetesVersionEnvVar); v != "" {
+		return v
+	}
+
+	url := ciArtifactsBaseURL() + "/latest-green.txt"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	Expect(err).NotTo(HaveOccurred(), "failed to build request for %s", url)
+
+	resp, err := http.DefaultClient.Do(req)
+	Expect(err).NotTo(HaveOccurred(), "failed to fetch latest CI version from %s", url)
+	defer resp.Body.Close()
+	Expect(resp.StatusCode).To(Equal(http.StatusOK), "unexpected status fetching %s", url)
+
+	body, err := io.ReadAll(resp.Body)
+	Expect(err).NotTo(HaveOccurred(), "failed to read latest CI version from %s", url)
+
+	return strings.TrimSpace(string(body))
+}
+
+func ciArtifactsBaseURL() string {
+	if u := os.Getenv(ciArtifactsURLEnvVar); u != "" {
+		return u
+	}
+	return defaultCIArtifactsURL
+}
+
+// downloadCIArtifacts downloads the kubeadm/kubelet/kubectl binaries for the given CI version into destDir,
+// mirroring the directory layout expected by the image-builder "node" role so they can be baked into the
+// workload image with a simple file copy.
+func downloadCIArtifacts(ctx context.Context, destDir, version string) {
+	baseURL := fmt.Sprintf("%s/%s", ciArtifactsBaseURL(), version)
+
+	for _, artifact := range ciArtifacts {
+		url := fmt.Sprintf("%s/%s", baseURL, artifact.sourcePath)
+		dest := filepath.Join(destDir, filepath.Base(artifact.targetPath))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		Expect(err).NotTo(HaveOccurred(), "failed to build request for %s", url)
+
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred(), "failed to download CI artifact %s", url)
+		func() {
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK), "unexpected status downloading %s", url)
+
+			f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, artifact.targetMode)
+			Expect(err).NotTo(HaveOccurred(), "failed to create %s", dest)
+			defer f.Close()
+
+			_, err = f.ReadFrom(resp.Body)
+			Expect(err).NotTo(HaveOccurred(), "failed to write %s", dest)
+		}()
+	}
+}
@@ -0,0 +1,155 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+)
+
+const defaultConformanceFlavor = "conformance"
+
+// ConformanceSpecInput is the input for RunConformanceTests.
+type ConformanceSpecInput struct {
+	E2EContext *E2EContext
+
+	// Namespace and ClusterName identify the workload cluster to provision, usually the values written
+	// by shared.SetupSpecNamespace.
+	Namespace   string
+	ClusterName string
+
+	// Flavor is the clusterctl template flavor to provision, defaulting to "conformance".
+	Flavor string
+
+	// WorkerMachineCount is the number of worker machines to provision, defaulting to 1.
+	WorkerMachineCount int64
+
+	// Fast, when set, runs the conformance-fast.yaml focus/skip list instead of the full conformance suite.
+	Fast bool
+}
+
+// RunConformanceTests provisions a workload cluster the same way the quick-start spec does, waits for its
+// addons to come up, then runs the installed kubetest binary against that cluster using the upstream
+// Kubernetes conformance focus/skip regexes, mirroring sigs.k8s.io/cluster-api/test/e2e/conformance.
+func RunConformanceTests(ctx context.Context, input ConformanceSpecInput) {
+	Expect(input.E2EContext).NotTo(BeNil(), "E2EContext is required for RunConformanceTests")
+	Expect(input.Namespace).NotTo(BeEmpty(), "Namespace is required for RunConformanceTests")
+	Expect(input.ClusterName).NotTo(BeEmpty(), "ClusterName is required for RunConformanceTests")
+
+	specName := "conformance-fast.yaml"
+	if !input.Fast {
+		specName = "conformance.yaml"
+	}
+	ginkgoConfigPath := filepath.Join("data", "kubetest", specName)
+	Expect(ginkgoConfigPath).To(BeAnExistingFile(), "missing kubetest ginkgo config %s", ginkgoConfigPath)
+
+	// Download the CI-built kubeadm/kubelet/kubectl into artifactsDir and point the cluster template at
+	// the resolved CI version, so the OpenStackMachine's pre-kubeadm commands fetch the matching binaries
+	// onto each node. Actually baking them into the workload image ahead of time is the job of the
+	// image-builder pipeline that produces the OpenStack glance image referenced by IMAGE_PATH; that
+	// pipeline lives outside this repository and isn't driven from here.
+	artifactsDir := filepath.Join(input.E2EContext.Settings.ArtifactFolder, "ci-artifacts")
+	Expect(os.MkdirAll(artifactsDir, 0755)).To(Succeed())
+	ciVersion := resolveCIVersion(ctx)
+	downloadCIArtifacts(ctx, artifactsDir, ciVersion)
+	input.E2EContext.E2EConfig.Variables["KUBERNETES_VERSION"] = ciVersion
+
+	workloadProxy := provisionConformanceCluster(ctx, input)
+
+	By(fmt.Sprintf("Running kubetest conformance suite (%s) against the workload cluster", specName))
+	runKubetest(ctx, kubetestRunInput{
+		KubeconfigPath:   workloadProxy.GetKubeconfigPath(),
+		GinkgoConfigPath: ginkgoConfigPath,
+		ArtifactsDir:     filepath.Join(input.E2EContext.Settings.ArtifactFolder, "kubetest-conformance"),
+	})
+}
+
+// provisionConformanceCluster applies the cluster template and waits for the control plane and worker
+// nodes to become ready, the same way scale.go's provisionScaleCluster does for the scale suite, then
+// waits for the configured addons to come up before handing back the workload cluster proxy.
+func provisionConformanceCluster(ctx context.Context, input ConformanceSpecInput) framework.ClusterProxy {
+	flavor := input.Flavor
+	if flavor == "" {
+		flavor = defaultConformanceFlavor
+	}
+	workerCount := input.WorkerMachineCount
+	if workerCount == 0 {
+		workerCount = 1
+	}
+	controlPlaneCount := int64(1)
+
+	By(fmt.Sprintf("Provisioning workload cluster %s/%s (flavor=%s)", input.Namespace, input.ClusterName, flavor))
+
+	_, err := clusterctl.ApplyClusterTemplateAndWait(ctx, clusterctl.ApplyClusterTemplateAndWaitInput{
+		ClusterProxy: input.E2EContext.Environment.BootstrapClusterProxy,
+		ConfigCluster: clusterctl.ConfigClusterInput{
+			LogFolder:                filepath.Join(input.E2EContext.Settings.ArtifactFolder, "clusters", input.ClusterName),
+			ClusterctlConfigPath:     input.E2EContext.Environment.ClusterctlConfigPath,
+			KubeconfigPath:           input.E2EContext.Environment.BootstrapClusterProxy.GetKubeconfigPath(),
+			InfrastructureProvider:   "openstack",
+			Flavor:                   flavor,
+			Namespace:                input.Namespace,
+			ClusterName:              input.ClusterName,
+			KubernetesVersion:        input.E2EContext.E2EConfig.GetVariable("KUBERNETES_VERSION"),
+			ControlPlaneMachineCount: &controlPlaneCount,
+			WorkerMachineCount:       &workerCount,
+		},
+		WaitForClusterIntervals:      input.E2EContext.E2EConfig.GetIntervals("conformance", "wait-cluster"),
+		WaitForControlPlaneIntervals: input.E2EContext.E2EConfig.GetIntervals("conformance", "wait-control-plane"),
+		WaitForMachineDeployments:    input.E2EContext.E2EConfig.GetIntervals("conformance", "wait-worker-nodes"),
+	})
+	Expect(err).NotTo(HaveOccurred(), "failed to provision conformance workload cluster")
+
+	workloadProxy := input.E2EContext.Environment.BootstrapClusterProxy.GetWorkloadCluster(ctx, input.Namespace, input.ClusterName)
+	Expect(workloadProxy).NotTo(BeNil(), "failed to get workload cluster proxy for %s/%s", input.Namespace, input.ClusterName)
+
+	WaitForDefaultAddonsReady(ctx, input.E2EContext.E2EConfig, workloadProxy, input.E2EContext.E2EConfig.GetIntervals("conformance", "wait-deployment")...)
+
+	return workloadProxy
+}
+
+type kubetestRunInput struct {
+	KubeconfigPath   string
+	GinkgoConfigPath string
+	ArtifactsDir     string
+}
+
+// runKubetest shells out to the kubetest binary installed alongside the e2e suite. It is kept as a thin
+// wrapper so there is a single place that knows how to invoke kubetest; only RunConformanceTests calls it.
+func runKubetest(ctx context.Context, input kubetestRunInput) {
+	Expect(os.MkdirAll(input.ArtifactsDir, 0755)).To(Succeed())
+
+	cmd := exec.CommandContext(ctx, "kubetest", //nolint:gosec
+		"--provider=skeleton",
+		"--test",
+		fmt.Sprintf("--kubeconfig=%s", input.KubeconfigPath),
+		fmt.Sprintf("--extract-ginkgo-config=%s", input.GinkgoConfigPath),
+		fmt.Sprintf("--dump=%s", input.ArtifactsDir),
+	)
+	output, err := cmd.CombinedOutput()
+	Expect(err).NotTo(HaveOccurred(), "kubetest run failed, output:\n%s", string(output))
+}
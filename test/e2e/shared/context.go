@@ -0,0 +1,162 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shared holds helpers that are common to every e2e test suite in this repository: bootstrapping
+// the management cluster, generating the clusterctl local repository and driving workload cluster creation.
+package shared
+
+import (
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/bootstrap"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+)
+
+// Mode selects which class of e2e spec an E2EContext is being used for. Its only effect is the prefix
+// SetupSpecNamespace gives spec namespaces; setupBootstrapCluster and initBootstrapCluster do not read it,
+// and CI artifact download, kubetest and scale bookkeeping run from RunConformanceTests and RunScaleTest.
+type Mode string
+
+const (
+	// DefaultMode runs the regular functional specs (quick-start, upgrades, and so on).
+	DefaultMode Mode = ""
+	// ConformanceMode runs the upstream Kubernetes conformance suite via kubetest against a workload cluster.
+	ConformanceMode Mode = "conformance"
+	// ScaleMode creates many concurrent workload clusters to measure controller throughput.
+	ScaleMode Mode = "scale"
+)
+
+// E2EContext bundles everything a spec needs to talk to the bootstrap and workload clusters.
+type E2EContext struct {
+	// E2EConfig is the parsed e2e test config.
+	E2EConfig *clusterctl.E2EConfig
+
+	// Environment holds the live handles to the bootstrap cluster.
+	Environment struct {
+		BootstrapClusterProvider bootstrap.ClusterProvider
+		BootstrapClusterProxy    framework.ClusterProxy
+		ClusterctlConfigPath     string
+	}
+
+	// Settings holds static, CLI-flag-derived options.
+	Settings struct {
+		ArtifactFolder     string
+		UseExistingCluster bool
+	}
+
+	// Mode is the class of spec this context is being used for.
+	Mode Mode
+}
+
+// E2EContextOption configures an E2EContext returned by NewE2EContext.
+type E2EContextOption func(*E2EContext)
+
+// WithMode sets the Mode an E2EContext runs in, e.g. ConformanceMode or ScaleMode.
+func WithMode(mode Mode) E2EContextOption {
+	return func(e2eCtx *E2EContext) {
+		e2eCtx.Mode = mode
+	}
+}
+
+// NewE2EContext builds an E2EContext ready for use by initBootstrapCluster/setupBootstrapCluster.
+func NewE2EContext(opts ...E2EContextOption) *E2EContext {
+	e2eCtx := &E2EContext{}
+	for _, opt := range opts {
+		opt(e2eCtx)
+	}
+	return e2eCtx
+}
+
+// BootstrapInput is the input for Bootstrap.
+type BootstrapInput struct {
+	// E2EConfigPath is the path to the e2e config file (usually the -e2e.config flag value).
+	E2EConfigPath string
+	// ArtifactFolder is where clusterctl repositories, logs and other test artifacts are written
+	// (usually the -e2e.artifacts-folder flag value).
+	ArtifactFolder string
+	// UseExistingCluster reuses the current kubeconfig context as the bootstrap cluster instead of
+	// creating a new kind cluster.
+	UseExistingCluster bool
+	// Scheme is used by the bootstrap cluster proxy; callers must add their own API groups to it.
+	Scheme *runtime.Scheme
+}
+
+// Bootstrap parses input.E2EConfigPath into e2eCtx.E2EConfig, generates the clusterctl local repository,
+// creates (or reuses) the bootstrap cluster and installs Cluster API and the infrastructure provider into
+// it. This is the exported entry point every suite package (apivalidations excepted, which only needs
+// envtest) calls from its own BeforeSuite, mirroring the quick-start suite's bootstrap flow.
+func Bootstrap(ctx context.Context, e2eCtx *E2EContext, input BootstrapInput) {
+	Expect(input.E2EConfigPath).To(BeAnExistingFile(), "e2e.config must point to an existing file")
+
+	e2eCtx.Settings.ArtifactFolder = input.ArtifactFolder
+	e2eCtx.Settings.UseExistingCluster = input.UseExistingCluster
+	e2eCtx.E2EConfig = clusterctl.LoadE2EConfig(ctx, clusterctl.LoadE2EConfigInput{ConfigPath: input.E2EConfigPath})
+	Expect(e2eCtx.E2EConfig).NotTo(BeNil(), "Failed to load E2E config from %s", input.E2EConfigPath)
+
+	repositoryFolder := filepath.Join(input.ArtifactFolder, "repository")
+	e2eCtx.Environment.ClusterctlConfigPath = createClusterctlLocalRepository(e2eCtx.E2EConfig, repositoryFolder)
+
+	e2eCtx.Environment.BootstrapClusterProvider, e2eCtx.Environment.BootstrapClusterProxy =
+		setupBootstrapCluster(e2eCtx.E2EConfig, input.Scheme, input.UseExistingCluster)
+
+	initBootstrapCluster(e2eCtx)
+}
+
+// TearDown disposes of the bootstrap cluster proxy and provider created by Bootstrap.
+func TearDown(e2eCtx *E2EContext) {
+	if e2eCtx == nil {
+		return
+	}
+	tearDown(e2eCtx.Environment.BootstrapClusterProvider, e2eCtx.Environment.BootstrapClusterProxy)
+}
+
+// SetupSpecNamespace creates a namespace for a single spec run and derives a cluster name from it,
+// writing both back into namespace/clusterName so AfterEach-style cleanup can refer to them.
+func SetupSpecNamespace(ctx context.Context, e2eCtx *E2EContext, namespace, clusterName *string) {
+	proxy := e2eCtx.Environment.BootstrapClusterProxy
+	Expect(proxy).NotTo(BeNil(), "BootstrapClusterProxy must be set before calling SetupSpecNamespace")
+
+	ns := &corev1.Namespace{}
+	ns.GenerateName = string(e2eCtx.Mode) + "-"
+	if ns.GenerateName == "-" {
+		ns.GenerateName = "e2e-"
+	}
+	Expect(proxy.GetClient().Create(ctx, ns)).To(Succeed(), "failed to create spec namespace")
+
+	*namespace = ns.Name
+	*clusterName = ns.Name
+}
+
+// TearDownSpecNamespace deletes the namespace created by SetupSpecNamespace.
+func TearDownSpecNamespace(ctx context.Context, e2eCtx *E2EContext, namespace string) {
+	if namespace == "" {
+		return
+	}
+	proxy := e2eCtx.Environment.BootstrapClusterProxy
+	ns := &corev1.Namespace{}
+	ns.Name = namespace
+	Expect(proxy.GetClient().Delete(ctx, ns, client.PropagationPolicy(metav1.DeletePropagationForeground))).To(Succeed(), "failed to delete spec namespace")
+}
@@ -0,0 +1,38 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import "flag"
+
+// Flags are the -e2e.* flags every suite that calls Bootstrap needs. Each suite package declares its own
+// package-level vars and calls BindFlags from an init() func, so `go test -e2e.config=...` behaves the
+// same way across conformance and scale.
+type Flags struct {
+	ConfigPath         string
+	ArtifactFolder     string
+	UseExistingCluster bool
+}
+
+// BindFlags registers the -e2e.config, -e2e.artifacts-folder and -e2e.use-existing-cluster flags,
+// writing their values into f.
+func BindFlags(f *Flags) {
+	flag.StringVar(&f.ConfigPath, "e2e.config", "", "path to the e2e config file")
+	flag.StringVar(&f.ArtifactFolder, "e2e.artifacts-folder", "_artifacts", "folder where e2e test artifacts should be stored")
+	flag.BoolVar(&f.UseExistingCluster, "e2e.use-existing-cluster", false, "if true, the test uses the current cluster as a bootstrap cluster instead of creating a new one")
+}
@@ -0,0 +1,178 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+)
+
+const (
+	// scaleConcurrencyEnvVar caps how many OpenStackCluster/OpenStackMachineDeployment pairs are created at once.
+	scaleConcurrencyEnvVar = "CONCURRENCY"
+	// scaleClusterCountEnvVar is the total number of workload clusters to create during the scale test.
+	scaleClusterCountEnvVar = "CLUSTER_COUNT"
+	// scaleWorkerCountEnvVar is the number of worker machines per workload cluster.
+	scaleWorkerCountEnvVar = "WORKER_MACHINE_COUNT"
+
+	defaultScaleConcurrency  = 5
+	defaultScaleClusterCount = 10
+	defaultScaleWorkerCount  = 1
+)
+
+// ScaleSpecInput is the input for RunScaleTest.
+type ScaleSpecInput struct {
+	E2EContext *E2EContext
+
+	// Concurrency overrides CONCURRENCY when non-zero.
+	Concurrency int
+	// ClusterCount overrides CLUSTER_COUNT when non-zero.
+	ClusterCount int
+	// WorkerMachineCount overrides WORKER_MACHINE_COUNT when non-zero.
+	WorkerMachineCount int
+
+	// FlavorName is the clusterctl template flavor used for each workload cluster, e.g. "scale".
+	FlavorName string
+}
+
+// clusterTiming records how long a single workload cluster took to become fully provisioned.
+type clusterTiming struct {
+	ClusterName string
+	Created     time.Time
+	Ready       time.Time
+	Err         error
+}
+
+func (t clusterTiming) duration() time.Duration {
+	if t.Err != nil {
+		return 0
+	}
+	return t.Ready.Sub(t.Created)
+}
+
+// RunScaleTest creates ClusterCount OpenStackCluster/OpenStackMachineDeployment pairs, at most Concurrency at a
+// time, and reports per-cluster provisioning timing. The test fails only when a cluster fails to become ready;
+// provisioning times are reported but not asserted on.
+func RunScaleTest(ctx context.Context, input ScaleSpecInput) {
+	Expect(input.E2EContext).NotTo(BeNil(), "E2EContext is required for RunScaleTest")
+
+	concurrency := intFromEnvOrDefault(scaleConcurrencyEnvVar, input.Concurrency, defaultScaleConcurrency)
+	clusterCount := intFromEnvOrDefault(scaleClusterCountEnvVar, input.ClusterCount, defaultScaleClusterCount)
+	workerCount := intFromEnvOrDefault(scaleWorkerCountEnvVar, input.WorkerMachineCount, defaultScaleWorkerCount)
+
+	By(fmt.Sprintf("Creating %d workload clusters (%d concurrent, %d workers each)", clusterCount, concurrency, workerCount))
+
+	timings := make([]clusterTiming, clusterCount)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < clusterCount; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			timings[i] = provisionScaleCluster(ctx, input, i, workerCount)
+		}(i)
+	}
+	wg.Wait()
+
+	reportScaleTimings(timings)
+
+	for _, t := range timings {
+		Expect(t.Err).NotTo(HaveOccurred(), "cluster %s failed to become ready", t.ClusterName)
+	}
+}
+
+func provisionScaleCluster(ctx context.Context, input ScaleSpecInput, index, workerCount int) clusterTiming {
+	// provisionScaleCluster runs on its own goroutine (see RunScaleTest); ApplyClusterTemplateAndWait and
+	// WaitForDefaultAddonsReady below both use Gomega assertions, which panic instead of just failing the
+	// current goroutine unless GinkgoRecover runs first.
+	defer GinkgoRecover()
+
+	clusterName := fmt.Sprintf("%s-scale-%d", input.E2EContext.E2EConfig.GetVariable("CLUSTER_NAME_PREFIX"), index)
+	timing := clusterTiming{ClusterName: clusterName, Created: time.Now()}
+
+	controlPlaneCount := int64(1)
+	workerCount64 := int64(workerCount)
+
+	// Applying the cluster template and waiting for readiness reuses the same clusterctl
+	// generate+apply and MachineDeployment/Cluster readiness waiters as the quick-start spec.
+	_, err := clusterctl.ApplyClusterTemplateAndWait(ctx, clusterctl.ApplyClusterTemplateAndWaitInput{
+		ClusterProxy: input.E2EContext.Environment.BootstrapClusterProxy,
+		ConfigCluster: clusterctl.ConfigClusterInput{
+			LogFolder:                filepath.Join(input.E2EContext.Settings.ArtifactFolder, "clusters", clusterName),
+			ClusterctlConfigPath:     input.E2EContext.Environment.ClusterctlConfigPath,
+			KubeconfigPath:           input.E2EContext.Environment.BootstrapClusterProxy.GetKubeconfigPath(),
+			InfrastructureProvider:   "openstack",
+			Flavor:                   input.FlavorName,
+			Namespace:                clusterName,
+			ClusterName:              clusterName,
+			KubernetesVersion:        input.E2EContext.E2EConfig.GetVariable("KUBERNETES_VERSION"),
+			ControlPlaneMachineCount: &controlPlaneCount,
+			WorkerMachineCount:       &workerCount64,
+		},
+		WaitForClusterIntervals:      input.E2EContext.E2EConfig.GetIntervals("scale", "wait-cluster"),
+		WaitForControlPlaneIntervals: input.E2EContext.E2EConfig.GetIntervals("scale", "wait-control-plane"),
+		WaitForMachineDeployments:    input.E2EContext.E2EConfig.GetIntervals("scale", "wait-worker-nodes"),
+	})
+	if err == nil {
+		workloadProxy := input.E2EContext.Environment.BootstrapClusterProxy.GetWorkloadCluster(ctx, clusterName, clusterName)
+		WaitForDefaultAddonsReady(ctx, input.E2EContext.E2EConfig, workloadProxy, input.E2EContext.E2EConfig.GetIntervals("scale", "wait-deployment")...)
+	}
+	timing.Err = err
+	timing.Ready = time.Now()
+	return timing
+}
+
+func reportScaleTimings(timings []clusterTiming) {
+	var total time.Duration
+	for _, t := range timings {
+		status := "ready"
+		if t.Err != nil {
+			status = "failed"
+		}
+		GinkgoWriter.Printf("cluster=%s status=%s duration=%s\n", t.ClusterName, status, t.duration())
+		total += t.duration()
+	}
+	if len(timings) > 0 {
+		GinkgoWriter.Printf("average cluster provisioning time: %s\n", total/time.Duration(len(timings)))
+	}
+}
+
+func intFromEnvOrDefault(envVar string, override, def int) int {
+	if override != 0 {
+		return override
+	}
+	if v := os.Getenv(envVar); v != "" {
+		parsed, err := strconv.Atoi(v)
+		Expect(err).NotTo(HaveOccurred(), "%s must be an integer, got %q", envVar, v)
+		return parsed
+	}
+	return def
+}
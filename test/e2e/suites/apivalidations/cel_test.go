@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apivalidations
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+// These specs exercise the x-kubernetes-validations CEL rules carried by the OpenStackCluster and
+// OpenStackMachine CRDs. They cover cross-field invariants that used to live exclusively in the Go
+// validating webhooks, so the rules keep working even when the webhook pod is unavailable (e.g. during
+// cluster bootstrap before cert-manager has issued the webhook serving certificate).
+var _ = Describe("OpenStackCluster CEL validations", func() {
+	var namespace *corev1.Namespace
+
+	BeforeEach(func() {
+		namespace = createNamespace()
+	})
+
+	It("rejects changing identityRef.cloudName after creation", func() {
+		osCluster := &infrav1.OpenStackCluster{}
+		osCluster.Namespace = namespace.Name
+		osCluster.GenerateName = "cluster-"
+		osCluster.Spec.IdentityRef = infrav1.OpenStackIdentityReference{
+			CloudName: "original",
+			Name:      "openstack-credentials",
+		}
+		Expect(h.Client().Create(h.Ctx(), osCluster)).To(Succeed())
+
+		osCluster.Spec.IdentityRef.CloudName = "changed"
+		err := h.Client().Update(h.Ctx(), osCluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("identityRef.cloudName is immutable"))
+	})
+
+	It("rejects a floating IP combined with disableExternalNetwork", func() {
+		osCluster := &infrav1.OpenStackCluster{}
+		osCluster.Namespace = namespace.Name
+		osCluster.GenerateName = "cluster-"
+		osCluster.Spec.IdentityRef = infrav1.OpenStackIdentityReference{
+			CloudName: "openstack",
+			Name:      "openstack-credentials",
+		}
+		osCluster.Spec.APIServerFloatingIP = ptrTo("10.0.0.1")
+		osCluster.Spec.DisableExternalNetwork = ptrTo(true)
+
+		err := h.Client().Create(h.Ctx(), osCluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("floatingIP and disableExternalNetwork are mutually exclusive"))
+	})
+})
+
+var _ = Describe("OpenStackMachine CEL validations", func() {
+	var namespace *corev1.Namespace
+
+	BeforeEach(func() {
+		namespace = createNamespace()
+	})
+
+	It("rejects ports referencing both a network and a mismatched fixedIP subnet", func() {
+		osMachine := &infrav1.OpenStackMachine{}
+		osMachine.Namespace = namespace.Name
+		osMachine.GenerateName = "machine-"
+		osMachine.Spec.Flavor = ptrTo("m1.small")
+		osMachine.Spec.Image = infrav1.ImageParam{Filter: &infrav1.ImageFilter{Name: ptrTo("ubuntu")}}
+		osMachine.Spec.Ports = []infrav1.PortOpts{
+			{
+				Network: &infrav1.NetworkParam{ID: ptrTo("network-a")},
+				FixedIPs: []infrav1.FixedIP{
+					{Subnet: &infrav1.SubnetParam{ID: ptrTo("subnet-in-network-b")}},
+				},
+			},
+		}
+
+		err := h.Client().Create(h.Ctx(), osMachine)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("fixedIPs[].subnet must belong to the port's network"))
+	})
+
+	It("requires an availabilityZone when rootVolume uses a multi-AZ Volume storage type", func() {
+		osMachine := &infrav1.OpenStackMachine{}
+		osMachine.Namespace = namespace.Name
+		osMachine.GenerateName = "machine-"
+		osMachine.Spec.Flavor = ptrTo("m1.small")
+		osMachine.Spec.Image = infrav1.ImageParam{Filter: &infrav1.ImageFilter{Name: ptrTo("ubuntu")}}
+		osMachine.Spec.RootVolume = &infrav1.RootVolume{
+			SizeGiB: 20,
+			Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice},
+		}
+
+		err := h.Client().Create(h.Ctx(), osMachine)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("availabilityZone is required when rootVolume.storage.type is Volume"))
+	})
+
+	It("requires an availabilityZone when an additional block device uses a multi-AZ Volume storage type", func() {
+		osMachine := &infrav1.OpenStackMachine{}
+		osMachine.Namespace = namespace.Name
+		osMachine.GenerateName = "machine-"
+		osMachine.Spec.Flavor = ptrTo("m1.small")
+		osMachine.Spec.Image = infrav1.ImageParam{Filter: &infrav1.ImageFilter{Name: ptrTo("ubuntu")}}
+		osMachine.Spec.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+			{Name: "etcd", SizeGiB: 10, Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice}},
+		}
+
+		err := h.Client().Create(h.Ctx(), osMachine)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("availabilityZone is required when additionalBlockDevices[].storage.type is Volume"))
+	})
+})
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -17,39 +17,34 @@ limitations under the License.
 package apivalidations
 
 import (
-	"context"
-	"fmt"
 	"path/filepath"
-	"strconv"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes/scheme"
-	"k8s.io/client-go/rest"
+	logsapiv1 "k8s.io/component-base/logs/api/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/envtest"
-	"sigs.k8s.io/controller-runtime/pkg/envtest/komega"
-	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/test/e2e/testhelpers/envtest"
 )
 
-var (
-	cfg        *rest.Config
-	k8sClient  client.Client
-	testEnv    *envtest.Environment
-	testScheme *runtime.Scheme
-	ctx        = context.Background()
-	mgrCancel  context.CancelFunc
-	mgrDone    chan struct{}
-)
+// webhooks lists every webhook this suite installs, as a data-driven table rather than a hard-coded
+// sequence of Expect(...SetupWebhookWithManager...) calls in BeforeSuite. Adding a new webhook to the
+// suite means adding a line here.
+var webhooks = []envtest.RegisterFn{
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackMachineTemplateWebhook{}).SetupWebhookWithManager(mgr) },
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackMachineTemplateList{}).SetupWebhookWithManager(mgr) },
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackCluster{}).SetupWebhookWithManager(mgr) },
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackClusterTemplate{}).SetupWebhookWithManager(mgr) },
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackMachine{}).SetupWebhookWithManager(mgr) },
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackMachineList{}).SetupWebhookWithManager(mgr) },
+	func(mgr ctrl.Manager) error { return (&infrav1.OpenStackClusterList{}).SetupWebhookWithManager(mgr) },
+}
+
+var h *envtest.Harness
 
 func TestAPIs(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -58,102 +53,26 @@ func TestAPIs(t *testing.T) {
 }
 
 var _ = BeforeSuite(func() {
-	By("bootstrapping test environment")
-	testEnv = &envtest.Environment{
-		CRDDirectoryPaths: []string{
-			// NOTE: These are the bare CRDs without conversion webhooks
-			filepath.Join("..", "..", "..", "..", "config", "crd", "bases"),
-		},
-		ErrorIfCRDPathMissing: true,
-		WebhookInstallOptions: envtest.WebhookInstallOptions{
-			Paths: []string{
-				filepath.Join("..", "..", "..", "..", "config", "webhook"),
-			},
-		},
-	}
-
-	var err error
-	cfg, err = testEnv.Start()
-	Expect(err).NotTo(HaveOccurred(), "test environment should start")
-	Expect(cfg).NotTo(BeNil(), "test environment should return a configuration")
-	DeferCleanup(func() error {
-		By("tearing down the test environment")
-		return testEnv.Stop()
-	})
-
-	testScheme = scheme.Scheme
+	testScheme := scheme.Scheme
 	Expect(infrav1.AddToScheme(testScheme)).To(Succeed())
 
 	//+kubebuilder:scaffold:scheme
 
-	k8sClient, err = client.New(cfg, client.Options{Scheme: testScheme})
-	Expect(err).NotTo(HaveOccurred())
-	Expect(k8sClient).NotTo(BeNil())
-
-	// CEL requires Kube 1.25 and above, so check for the minimum server version.
-	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
-	Expect(err).ToNot(HaveOccurred())
-
-	serverVersion, err := discoveryClient.ServerVersion()
-	Expect(err).ToNot(HaveOccurred())
-
-	Expect(serverVersion.Major).To(Equal("1"))
-
-	minorInt, err := strconv.Atoi(serverVersion.Minor)
-	Expect(err).ToNot(HaveOccurred())
-	Expect(minorInt).To(BeNumerically(">=", 25), fmt.Sprintf("This test suite requires a Kube API server of at least version 1.25, current version is 1.%s", serverVersion.Minor))
-
-	komega.SetClient(k8sClient)
-	komega.SetContext(ctx)
-
-	By("Setting up manager and webhooks")
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		Scheme: testScheme,
-		Metrics: server.Options{
-			BindAddress: "0",
-		},
-		WebhookServer: webhook.NewServer(webhook.Options{
-			Port:    testEnv.WebhookInstallOptions.LocalServingPort,
-			Host:    testEnv.WebhookInstallOptions.LocalServingHost,
-			CertDir: testEnv.WebhookInstallOptions.LocalServingCertDir,
-		}),
-	})
-	Expect(err).ToNot(HaveOccurred(), "Manager setup should succeed")
-
-	Expect((&infrav1.OpenStackMachineTemplateWebhook{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackMachineTemplate webhook should be registered with manager")
-	Expect((&infrav1.OpenStackMachineTemplateList{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackMachineTemplateList webhook should be registered with manager")
-	Expect((&infrav1.OpenStackCluster{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackCluster webhook should be registered with manager")
-	Expect((&infrav1.OpenStackClusterTemplate{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackClusterTemplate webhook should be registered with manager")
-	Expect((&infrav1.OpenStackMachine{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackMachine webhook should be registered with manager")
-	Expect((&infrav1.OpenStackMachineList{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackMachineList webhook should be registered with manager")
-	Expect((&infrav1.OpenStackClusterList{}).SetupWebhookWithManager(mgr)).To(Succeed(), "OpenStackClusterList webhook should be registered with manager")
-
-	By("Starting manager")
-	var mgrCtx context.Context
-	mgrDone = make(chan struct{})
-	mgrCtx, mgrCancel = context.WithCancel(context.Background())
-
-	go func() {
-		defer GinkgoRecover()
-		defer close(mgrDone)
-		Expect(mgr.Start(mgrCtx)).To(Succeed(), "Manager should start")
-	}()
-	DeferCleanup(func() {
-		By("Tearing down manager")
-		mgrCancel()
-		Eventually(mgrDone).Should(BeClosed(), "Manager should stop")
-	})
+	loggingConfig := logsapiv1.NewLoggingConfiguration()
+	// Use the JSON format instead of the text default, so a regression in the JSON
+	// encoder shows up here instead of only in a live cluster.
+	loggingConfig.Format = "json"
+
+	h = envtest.NewTestEnv(
+		envtest.WithCRDPaths(filepath.Join("..", "..", "..", "..", "config", "crd", "bases")),
+		envtest.WithWebhooks([]string{filepath.Join("..", "..", "..", "..", "config", "webhook")}, webhooks...),
+		envtest.WithScheme(testScheme),
+		envtest.WithLoggingConfig(loggingConfig),
+		// CEL requires Kube 1.25 and above.
+		envtest.WithMinServerVersion(25),
+	)
 })
 
 func createNamespace() *corev1.Namespace {
-	By("Creating namespace")
-	namespace := corev1.Namespace{}
-	namespace.GenerateName = "test-"
-	Expect(k8sClient.Create(ctx, &namespace)).To(Succeed(), "Namespace creation should succeed")
-	DeferCleanup(func() {
-		By("Deleting namespace")
-		Expect(k8sClient.Delete(ctx, &namespace, client.PropagationPolicy(metav1.DeletePropagationForeground))).To(Succeed(), "Namespace deletion should succeed")
-	})
-	By(fmt.Sprintf("Using namespace %s", namespace.Name))
-	return &namespace
+	return h.EphemeralNamespace()
 }
@@ -0,0 +1,75 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/kubernetes/scheme"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/test/e2e/shared"
+)
+
+var (
+	ctx             = context.Background()
+	e2eCtx          *shared.E2EContext
+	flags           = &shared.Flags{}
+	namespace       string
+	clusterName     string
+	fastConformance bool
+)
+
+func init() {
+	shared.BindFlags(flags)
+	flag.BoolVar(&fastConformance, "e2e.fast-conformance", false, "run conformance-fast.yaml instead of the full conformance focus/skip list")
+}
+
+func TestE2E(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Conformance Suite")
+}
+
+var _ = BeforeSuite(func() {
+	testScheme := scheme.Scheme
+	Expect(clusterv1.AddToScheme(testScheme)).To(Succeed())
+	Expect(infrav1.AddToScheme(testScheme)).To(Succeed())
+
+	shared.RegisterDefaultAddons()
+
+	e2eCtx = shared.NewE2EContext(shared.WithMode(shared.ConformanceMode))
+	shared.Bootstrap(ctx, e2eCtx, shared.BootstrapInput{
+		E2EConfigPath:      flags.ConfigPath,
+		ArtifactFolder:     flags.ArtifactFolder,
+		UseExistingCluster: flags.UseExistingCluster,
+		Scheme:             testScheme,
+	})
+
+	shared.SetupSpecNamespace(ctx, e2eCtx, &namespace, &clusterName)
+})
+
+var _ = AfterSuite(func() {
+	shared.TearDownSpecNamespace(ctx, e2eCtx, namespace)
+	shared.TearDown(e2eCtx)
+})
@@ -0,0 +1,34 @@
+// +build e2e
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/test/e2e/shared"
+)
+
+var _ = Describe("Scale tests", func() {
+	It("should create many workload clusters concurrently without controller errors", func() {
+		shared.RunScaleTest(ctx, shared.ScaleSpecInput{
+			E2EContext: e2eCtx,
+			FlavorName: "scale",
+		})
+	})
+})
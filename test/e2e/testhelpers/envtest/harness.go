@@ -0,0 +1,224 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envtest provides a reusable envtest.Environment + manager harness for webhook test suites.
+// It exists so a suite can start a single envtest environment and manager per Ginkgo process, obtain an
+// isolated namespace per spec via Harness.EphemeralNamespace, and run specs in parallel with `ginkgo -p`
+// without racing on shared package-level state.
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	logsapiv1 "k8s.io/component-base/logs/api/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// RegisterFn registers a single webhook with the manager, e.g. (&infrav1.OpenStackCluster{}).SetupWebhookWithManager.
+// Webhooks are registered from a data-driven slice rather than being hard-coded in BeforeSuite, so adding a
+// new webhook to a suite doesn't require editing the suite bootstrap.
+type RegisterFn func(ctrl.Manager) error
+
+// options configures a Harness built by NewTestEnv.
+type options struct {
+	crdPaths       []string
+	webhookPaths   []string
+	registerFns    []RegisterFn
+	scheme         *runtime.Scheme
+	minServerMinor int
+	loggingConfig  *logsapiv1.LoggingConfiguration
+}
+
+// Option configures a Harness returned by NewTestEnv.
+type Option func(*options)
+
+// WithCRDPaths sets the directories envtest loads CRDs from.
+func WithCRDPaths(paths ...string) Option {
+	return func(o *options) {
+		o.crdPaths = append(o.crdPaths, paths...)
+	}
+}
+
+// WithWebhooks sets the webhook manifest directories envtest installs, and the functions used to
+// register each webhook with the manager once it starts.
+func WithWebhooks(webhookPaths []string, fns ...RegisterFn) Option {
+	return func(o *options) {
+		o.webhookPaths = append(o.webhookPaths, webhookPaths...)
+		o.registerFns = append(o.registerFns, fns...)
+	}
+}
+
+// WithScheme sets the scheme used by the manager and the harness client. Defaults to the client-go
+// scheme if not set; callers still need to AddToScheme their own API groups.
+func WithScheme(s *runtime.Scheme) Option {
+	return func(o *options) {
+		o.scheme = s
+	}
+}
+
+// WithMinServerVersion fails BeforeSuite unless the envtest API server is at least this Kube minor
+// version, e.g. 25 for CEL support. A value of 0 (the default) skips the check.
+func WithMinServerVersion(minor int) Option {
+	return func(o *options) {
+		o.minServerMinor = minor
+	}
+}
+
+// Harness bundles a running envtest.Environment and controller-runtime manager.
+type Harness struct {
+	Env *envtest.Environment
+
+	cfg       *rest.Config
+	k8sClient client.Client
+	ctx       context.Context
+	cancel    context.CancelFunc
+	mgrDone   chan struct{}
+}
+
+// NewTestEnv starts an envtest.Environment and controller-runtime manager configured by opts, and
+// registers a DeferCleanup to tear both down. Intended to be called once from a suite's BeforeSuite.
+func NewTestEnv(opts ...Option) *Harness {
+	o := &options{scheme: scheme.Scheme}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	By("bootstrapping test environment")
+	h := &Harness{
+		Env: &envtest.Environment{
+			CRDDirectoryPaths:     o.crdPaths,
+			ErrorIfCRDPathMissing: true,
+			WebhookInstallOptions: envtest.WebhookInstallOptions{
+				Paths: o.webhookPaths,
+			},
+		},
+	}
+
+	var err error
+	h.cfg, err = h.Env.Start()
+	Expect(err).NotTo(HaveOccurred(), "test environment should start")
+	Expect(h.cfg).NotTo(BeNil(), "test environment should return a configuration")
+	DeferCleanup(func() error {
+		By("tearing down the test environment")
+		return h.Env.Stop()
+	})
+
+	if o.minServerMinor > 0 {
+		requireMinServerVersion(h.cfg, o.minServerMinor)
+	}
+
+	h.k8sClient, err = client.New(h.cfg, client.Options{Scheme: o.scheme})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(h.k8sClient).NotTo(BeNil())
+
+	h.ctx = context.Background()
+	komega.SetClient(h.k8sClient)
+	komega.SetContext(h.ctx)
+
+	applyLoggingConfig(o.loggingConfig)
+
+	By("Setting up manager and webhooks")
+	mgr, err := ctrl.NewManager(h.cfg, ctrl.Options{
+		Scheme: o.scheme,
+		Metrics: server.Options{
+			BindAddress:   "0",
+			ExtraHandlers: debugFlagsHandlers(),
+		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    h.Env.WebhookInstallOptions.LocalServingPort,
+			Host:    h.Env.WebhookInstallOptions.LocalServingHost,
+			CertDir: h.Env.WebhookInstallOptions.LocalServingCertDir,
+		}),
+	})
+	Expect(err).ToNot(HaveOccurred(), "Manager setup should succeed")
+
+	for _, fn := range o.registerFns {
+		Expect(fn(mgr)).To(Succeed(), "webhook should be registered with manager")
+	}
+
+	By("Starting manager")
+	var mgrCtx context.Context
+	h.mgrDone = make(chan struct{})
+	mgrCtx, h.cancel = context.WithCancel(context.Background())
+
+	go func() {
+		defer GinkgoRecover()
+		defer close(h.mgrDone)
+		Expect(mgr.Start(mgrCtx)).To(Succeed(), "Manager should start")
+	}()
+	DeferCleanup(func() {
+		By("Tearing down manager")
+		h.cancel()
+		Eventually(h.mgrDone).Should(BeClosed(), "Manager should stop")
+	})
+
+	return h
+}
+
+// Client returns the harness's controller-runtime client.
+func (h *Harness) Client() client.Client {
+	return h.k8sClient
+}
+
+// Ctx returns the background context used to start the harness.
+func (h *Harness) Ctx() context.Context {
+	return h.ctx
+}
+
+// EphemeralNamespace creates a namespace for a single spec and registers its deletion via t.Cleanup-style
+// Ginkgo DeferCleanup, so specs using it are safe to run in parallel with `ginkgo -p`.
+func (h *Harness) EphemeralNamespace() *corev1.Namespace {
+	By("Creating namespace")
+	namespace := &corev1.Namespace{}
+	namespace.GenerateName = "test-"
+	Expect(h.k8sClient.Create(h.ctx, namespace)).To(Succeed(), "Namespace creation should succeed")
+	DeferCleanup(func() {
+		By("Deleting namespace")
+		Expect(h.k8sClient.Delete(h.ctx, namespace, client.PropagationPolicy(metav1.DeletePropagationForeground))).To(Succeed(), "Namespace deletion should succeed")
+	})
+	By(fmt.Sprintf("Using namespace %s", namespace.Name))
+	return namespace
+}
+
+func requireMinServerVersion(cfg *rest.Config, minMinor int) {
+	// CEL requires Kube 1.25 and above, so check for the minimum server version.
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	Expect(err).ToNot(HaveOccurred())
+
+	serverVersion, err := discoveryClient.ServerVersion()
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(serverVersion.Major).To(Equal("1"))
+
+	minorInt, err := strconv.Atoi(serverVersion.Minor)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(minorInt).To(BeNumerically(">=", minMinor), fmt.Sprintf("This test suite requires a Kube API server of at least version 1.%d, current version is 1.%s", minMinor, serverVersion.Minor))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest
+
+import (
+	"flag"
+	"io"
+	"net/http"
+	"sync"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	"k8s.io/component-base/featuregate"
+	"k8s.io/component-base/logs"
+	logsapiv1 "k8s.io/component-base/logs/api/v1"
+	_ "k8s.io/component-base/logs/json/register" // registers the "json" logging format
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// klogFlags is a dedicated FlagSet for klog.InitFlags, so reading back "-v" doesn't require (or pollute)
+// the process's global flag.CommandLine, which this package's callers (the e2e suites) use for their own
+// -e2e.* flags.
+var (
+	klogFlags     = flag.NewFlagSet("klog", flag.ContinueOnError)
+	klogFlagsOnce sync.Once
+)
+
+func ensureKlogFlagsRegistered() {
+	klogFlagsOnce.Do(func() {
+		klog.InitFlags(klogFlags)
+	})
+}
+
+// WithLoggingConfig validates cfg and applies it to the controller-runtime global logger before the
+// manager is created, so the envtest manager started by NewTestEnv logs with the same format/verbosity
+// the real manager would use for the same LoggingConfiguration. This lets log-format regressions (e.g. a
+// broken --logging-format=json flag) be caught by this suite instead of only showing up in a live cluster.
+//
+// This only covers manager-level format/verbosity. The OpenStack*Webhook validators in api/v1beta1 do
+// not log, so admission failures carry no per-request fields (gvk, namespace, name, user).
+func WithLoggingConfig(cfg *logsapiv1.LoggingConfiguration) Option {
+	return func(o *options) {
+		o.loggingConfig = cfg
+	}
+}
+
+// applyLoggingConfig validates and applies cfg, defaulting to klog's text format when cfg is nil, and
+// installs klog as the controller-runtime logger in either case.
+func applyLoggingConfig(cfg *logsapiv1.LoggingConfiguration) {
+	if cfg == nil {
+		cfg = logsapiv1.NewLoggingConfiguration()
+	}
+
+	Expect(logsapiv1.ValidateAndApply(cfg, featuregate.NewFeatureGate())).To(Succeed(), "logging configuration should be valid")
+
+	ctrl.SetLogger(klog.Background())
+}
+
+// debugFlagsHandlers returns the "/debug/flags/v" handler the real manager exposes so operators can flip
+// klog verbosity at runtime without a restart (PUT a new -v value, GET to read the current one),
+// registered on the envtest manager's metrics server under the same path so this suite exercises the
+// same wiring.
+func debugFlagsHandlers() map[string]http.Handler {
+	ensureKlogFlagsRegistered()
+
+	return map[string]http.Handler{
+		"/debug/flags/v": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				if _, err := logs.GlogSetter(string(body)); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+			case http.MethodGet:
+			default:
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			if vFlag := klogFlags.Lookup("v"); vFlag != nil {
+				_, _ = io.WriteString(w, vFlag.Value.String())
+			}
+		}),
+	}
+}
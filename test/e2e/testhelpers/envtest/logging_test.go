@@ -0,0 +1,58 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDebugFlagsVHandlerRoundTrips(t *testing.T) {
+	handler := debugFlagsHandlers()["/debug/flags/v"]
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	putReq, err := http.NewRequest(http.MethodPut, srv.URL, strings.NewReader("4"))
+	if err != nil {
+		t.Fatalf("failed to build PUT -v request: %v", err)
+	}
+	putResp, err := http.DefaultClient.Do(putReq)
+	if err != nil {
+		t.Fatalf("PUT -v failed: %v", err)
+	}
+	defer putResp.Body.Close()
+	if putResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(putResp.Body)
+		t.Fatalf("PUT -v returned %d: %s", putResp.StatusCode, body)
+	}
+
+	getResp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET -v failed: %v", err)
+	}
+	defer getResp.Body.Close()
+	got, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		t.Fatalf("failed to read GET -v response: %v", err)
+	}
+	if string(got) != "4" {
+		t.Errorf("GET -v = %q, want %q", got, "4")
+	}
+}